@@ -0,0 +1,135 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleVertexAIEndpoint() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceVertexAIEndpoint().Schema)
+
+	addRequiredFieldsToSchema(dsSchema, "region")
+	addOptionalFieldsToSchema(dsSchema, "name", "display_name", "project")
+
+	return &schema.Resource{
+		Read:   dataSourceGoogleVertexAIEndpointRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGoogleVertexAIEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	billingProject := project
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	name, hasName := d.GetOk("name")
+	displayName, hasDisplayName := d.GetOk("display_name")
+
+	if !hasName && !hasDisplayName {
+		return fmt.Errorf("one of `name` or `display_name` must be set")
+	}
+
+	var endpoint map[string]interface{}
+
+	if hasName {
+		if err := d.Set("name", name); err != nil {
+			return fmt.Errorf("Error setting name: %s", err)
+		}
+		// name may be the full resource name, as the resource exports it, or
+		// just the endpoint id within project and region.
+		urlTemplate := "{{VertexAIBasePath}}projects/{{project}}/locations/{{region}}/endpoints/{{name}}"
+		if strings.HasPrefix(name.(string), "projects/") {
+			urlTemplate = "{{VertexAIBasePath}}{{name}}"
+		}
+		url, err := replaceVars(d, config, urlTemplate)
+		if err != nil {
+			return err
+		}
+		endpoint, err = sendRequest(config, "GET", billingProject, url, userAgent, nil)
+		if err != nil {
+			return handleNotFoundError(err, d, fmt.Sprintf("VertexAIEndpoint %q", name))
+		}
+		if err := d.Set("name", endpoint["name"]); err != nil {
+			return fmt.Errorf("Error setting name: %s", err)
+		}
+	} else {
+		url, err := replaceVars(d, config, "{{VertexAIBasePath}}projects/{{project}}/locations/{{region}}/endpoints")
+		if err != nil {
+			return err
+		}
+		url, err = addQueryParams(url, map[string]string{"filter": fmt.Sprintf("display_name=%q", displayName.(string))})
+		if err != nil {
+			return err
+		}
+		res, err := sendRequest(config, "GET", billingProject, url, userAgent, nil)
+		if err != nil {
+			return fmt.Errorf("Error listing Endpoints: %s", err)
+		}
+		endpoints, ok := res["endpoints"].([]interface{})
+		if !ok || len(endpoints) == 0 {
+			return fmt.Errorf("no VertexAIEndpoint found matching display_name %q", displayName)
+		}
+		if len(endpoints) > 1 {
+			return fmt.Errorf("more than one VertexAIEndpoint found matching display_name %q, use `name` to disambiguate", displayName)
+		}
+		endpoint = endpoints[0].(map[string]interface{})
+		if err := d.Set("name", endpoint["name"]); err != nil {
+			return fmt.Errorf("Error setting name: %s", err)
+		}
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("display_name", flattenVertexAIEndpointDisplayName(endpoint["displayName"], d, config)); err != nil {
+		return fmt.Errorf("Error setting display_name: %s", err)
+	}
+	if err := d.Set("create_time", flattenVertexAIEndpointCreateTime(endpoint["createTime"], d, config)); err != nil {
+		return fmt.Errorf("Error setting create_time: %s", err)
+	}
+	if err := d.Set("update_time", flattenVertexAIEndpointUpdateTime(endpoint["updateTime"], d, config)); err != nil {
+		return fmt.Errorf("Error setting update_time: %s", err)
+	}
+	if err := d.Set("labels", flattenVertexAIEndpointLabels(endpoint["labels"], d, config)); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
+	if err := d.Set("encryption_spec", flattenVertexAIEndpointEncryptionSpec(endpoint["encryptionSpec"], d, config)); err != nil {
+		return fmt.Errorf("Error setting encryption_spec: %s", err)
+	}
+	if err := d.Set("metadata_schema_uri", flattenVertexAIEndpointMetadataSchemaUri(endpoint["metadataSchemaUri"], d, config)); err != nil {
+		return fmt.Errorf("Error setting metadata_schema_uri: %s", err)
+	}
+	if err := d.Set("network", flattenVertexAIEndpointNetwork(endpoint["network"], d, config)); err != nil {
+		return fmt.Errorf("Error setting network: %s", err)
+	}
+	if err := d.Set("enable_private_service_connect", flattenVertexAIEndpointEnablePrivateServiceConnect(endpoint["privateServiceConnectConfig"], d, config)); err != nil {
+		return fmt.Errorf("Error setting enable_private_service_connect: %s", err)
+	}
+	if err := d.Set("private_service_connect_config", flattenVertexAIEndpointPrivateServiceConnectConfig(endpoint["privateServiceConnectConfig"], d, config)); err != nil {
+		return fmt.Errorf("Error setting private_service_connect_config: %s", err)
+	}
+
+	// name now holds the full resource name returned by the API.
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return nil
+}
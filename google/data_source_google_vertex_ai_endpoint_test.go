@@ -0,0 +1,156 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceGoogleVertexAIEndpoint_byName(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleVertexAIEndpoint_byName(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.google_vertex_ai_endpoint.endpoint", "name",
+						"google_vertex_ai_endpoint.endpoint", "name"),
+					resource.TestCheckResourceAttrPair(
+						"data.google_vertex_ai_endpoint.endpoint", "display_name",
+						"google_vertex_ai_endpoint.endpoint", "display_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleVertexAIEndpoint_byName(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_vertex_ai_endpoint" "endpoint" {
+  display_name        = "tf-test-endpoint%{random_suffix}"
+  metadata_schema_uri = ""
+  region               = "us-central1"
+}
+
+data "google_vertex_ai_endpoint" "endpoint" {
+  name   = google_vertex_ai_endpoint.endpoint.name
+  region = google_vertex_ai_endpoint.endpoint.region
+}
+`, context)
+}
+
+func TestAccDataSourceGoogleVertexAIEndpoint_byDisplayName(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleVertexAIEndpoint_byDisplayName(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.google_vertex_ai_endpoint.endpoint", "name",
+						"google_vertex_ai_endpoint.endpoint", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleVertexAIEndpoint_byDisplayName(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_vertex_ai_endpoint" "endpoint" {
+  display_name        = "tf-test-endpoint%{random_suffix}"
+  metadata_schema_uri = ""
+  region               = "us-central1"
+}
+
+data "google_vertex_ai_endpoint" "endpoint" {
+  display_name = google_vertex_ai_endpoint.endpoint.display_name
+  region       = google_vertex_ai_endpoint.endpoint.region
+
+  depends_on = [google_vertex_ai_endpoint.endpoint]
+}
+`, context)
+}
+
+func TestAccVertexAIEndpoint_importByFullNameAndShortForm(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckVertexAIEndpointDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVertexAIEndpoint_basic(context),
+			},
+			{
+				// d.Id() is always the fully-qualified
+				// projects/{project}/locations/{region}/endpoints/{id} form,
+				// so this step exercises that branch of parseImportId.
+				ResourceName:      "google_vertex_ai_endpoint.endpoint",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// This step exercises the short {project}/{region}/{id} form.
+				ResourceName:      "google_vertex_ai_endpoint.endpoint",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccVertexAIEndpointImportStateIdFuncShortForm("google_vertex_ai_endpoint.endpoint"),
+			},
+		},
+	})
+}
+
+// testAccVertexAIEndpointImportStateIdFuncShortForm builds the short
+// {project}/{region}/{id} import id from the resource's fully-qualified
+// name attribute, so the test can exercise that branch of
+// resourceVertexAIEndpointImport's parseImportId patterns.
+func testAccVertexAIEndpointImportStateIdFuncShortForm(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		matches := regexp.MustCompile(`^projects/([^/]+)/locations/([^/]+)/endpoints/([^/]+)$`).FindStringSubmatch(name)
+		if matches == nil {
+			return "", fmt.Errorf("name %q did not match the expected projects/{project}/locations/{region}/endpoints/{id} form", name)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", matches[1], matches[2], matches[3]), nil
+	}
+}
+
+func testAccVertexAIEndpoint_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_vertex_ai_endpoint" "endpoint" {
+  display_name        = "tf-test-endpoint%{random_suffix}"
+  metadata_schema_uri = ""
+  region               = "us-central1"
+}
+`, context)
+}
@@ -0,0 +1,172 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var IamVertexAIEndpointSchema = map[string]*schema.Schema{
+	"endpoint": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: `The name of the Endpoint resource, in the format projects/{project}/locations/{region}/endpoints/{endpoint}.`,
+	},
+	"region": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type VertexAIEndpointIamUpdater struct {
+	project  string
+	region   string
+	endpoint string
+	d        TerraformResourceData
+	Config   *Config
+}
+
+func NewVertexAIEndpointIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return nil, fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("region", region); err != nil {
+		return nil, fmt.Errorf("Error setting region: %s", err)
+	}
+
+	return &VertexAIEndpointIamUpdater{
+		project:  project,
+		region:   region,
+		endpoint: d.Get("endpoint").(string),
+		d:        d,
+		Config:   config,
+	}, nil
+}
+
+func VertexAIEndpointIdParseFunc(d *schema.ResourceData, config *Config) error {
+	fv, err := parseRegionalFieldValue("endpoints", d.Id(), "project", "region", "zone", d, config, true)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("endpoint", fv.RelativeLink()); err != nil {
+		return fmt.Errorf("Error setting endpoint: %s", err)
+	}
+	if err := d.Set("project", fv.Project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("region", fv.Region); err != nil {
+		return fmt.Errorf("Error setting region: %s", err)
+	}
+
+	// Explicitly set the id so it gets updated to the full project path, so
+	// that subsequent saves won't write to a wrong path.
+	d.SetId(fv.RelativeLink())
+	return nil
+}
+
+func (u *VertexAIEndpointIamUpdater) GetResourceIamPolicy(userAgent string) (*Policy, error) {
+	url, err := u.qualifyEndpointUrl("getIamPolicy")
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := getProject(u.d, u.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj map[string]interface{}
+	url, err = addQueryParams(url, map[string]string{"optionsRequestedPolicyVersion": fmt.Sprintf("%d", iamPolicyVersion)})
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := sendRequest(u.Config, "GET", project, url, userAgent, obj)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	out := &Policy{}
+	err = Convert(policy, out)
+	if err != nil {
+		return nil, errwrap.Wrapf("Cannot convert a policy to a resource-specific policy: {{err}}", err)
+	}
+
+	return out, nil
+}
+
+func (u *VertexAIEndpointIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+	json, err := ConvertToMap(policy)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"policy": json,
+	}
+
+	url, err := u.qualifyEndpointUrl("setIamPolicy")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(u.d, u.Config)
+	if err != nil {
+		return err
+	}
+
+	userAgent, err := generateUserAgentString(u.d, u.Config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	_, err = sendRequestWithTimeout(u.Config, "POST", project, url, userAgent, obj, u.d.Timeout(schema.TimeoutDefault))
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *VertexAIEndpointIamUpdater) qualifyEndpointUrl(methodIdentifier string) (string, error) {
+	urlTemplate := fmt.Sprintf("{{VertexAIBasePath}}%s:%s", u.GetResourceId(), methodIdentifier)
+	url, err := replaceVars(u.d, u.Config, urlTemplate)
+	if err != nil {
+		return "", err
+	}
+	return url, nil
+}
+
+func (u *VertexAIEndpointIamUpdater) GetResourceId() string {
+	return u.endpoint
+}
+
+func (u *VertexAIEndpointIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-vertex-ai-endpoint-%s", u.GetResourceId())
+}
+
+func (u *VertexAIEndpointIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("vertex ai endpoint %q", strings.TrimPrefix(u.GetResourceId(), "projects/"))
+}
@@ -0,0 +1,750 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// vertexAIEndpointTrafficSplitMutexKey returns the mutex key that guards the
+// shared trafficSplit map on a Vertex AI endpoint. Every DeployedModel and the
+// companion google_vertex_ai_endpoint_traffic_split resource must hold this
+// lock while they read-modify-write the parent endpoint, since the API only
+// exposes a single trafficSplit map for all deployed models on an endpoint.
+func vertexAIEndpointTrafficSplitMutexKey(endpoint string) string {
+	return fmt.Sprintf("google-vertex-ai-endpoint-traffic-split/%s", endpoint)
+}
+
+func resourceVertexAIDeployedModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVertexAIDeployedModelCreate,
+		Read:   resourceVertexAIDeployedModelRead,
+		Update: resourceVertexAIDeployedModelUpdate,
+		Delete: resourceVertexAIDeployedModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVertexAIDeployedModelImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"endpoint": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of the Endpoint resource into which this model is deployed, in the format projects/{project}/locations/{region}/endpoints/{endpoint}.`,
+			},
+			"model": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The resource name of the Model this is the deployment of, in the format projects/{project}/locations/{region}/models/{model}@{version}.`,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The display name of the DeployedModel. If not provided upon creation, the Model's display_name is used.`,
+			},
+			"service_account": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The service account that the DeployedModel's container runs as. Required for the model to access Google Cloud resources.`,
+			},
+			"enable_access_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `If true, online prediction access logs are sent to Cloud Logging.`,
+			},
+			"enable_container_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `If true, the container of the DeployedModel instances will send stderr and stdout streams to Cloud Logging. Only supported for custom-trained Models and AutoML Tabular Models.`,
+			},
+			"disable_container_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `If true, container logging from the DeployedModel is disabled. Only applicable to Models deployed with prebuilt containers that otherwise log to Cloud Logging by default.`,
+			},
+			"dedicated_resources": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"dedicated_resources", "automatic_resources"},
+				Description:  `A description of resources that are dedicated to the DeployedModel, and that need a higher degree of manual configuration.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"machine_spec": {
+							Type:        schema.TypeList,
+							Required:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Description: `The specification of a single machine used by the prediction.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"machine_type": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `The type of the machine, e.g. n1-standard-2.`,
+									},
+									"accelerator_type": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `The type of accelerator(s) that may be attached to the machine, e.g. NVIDIA_TESLA_K80.`,
+									},
+									"accelerator_count": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `The number of accelerators to attach to the machine.`,
+									},
+								},
+							},
+						},
+						"min_replica_count": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							ForceNew:    true,
+							Description: `The minimum number of machine replicas this DeployedModel will always be deployed on.`,
+						},
+						"max_replica_count": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `The maximum number of replicas this DeployedModel may be deployed on when the traffic against it increases. If not set, defaults to min_replica_count.`,
+						},
+						"autoscaling_metric_specs": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `The metric specifications that overrides the default autoscaling behavior for the DeployedModel's dedicated_resources.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										ForceNew:    true,
+										Description: `The resource metric name, e.g. aiplatform.googleapis.com/prediction/online/cpu/utilization.`,
+									},
+									"target": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										ForceNew:    true,
+										Description: `The target resource utilization in percentage (1% - 100%) for the given metric.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"automatic_resources": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"dedicated_resources", "automatic_resources"},
+				Description:  `A description of resources that to large degree are decided by Vertex AI, and require only a modest additional configuration.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_replica_count": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `The minimum number of replicas this DeployedModel will always be deployed on.`,
+						},
+						"max_replica_count": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `The maximum number of replicas this DeployedModel may be deployed on when the traffic against it increases.`,
+						},
+					},
+				},
+			},
+			"traffic_percentage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+				Description:  `The percentage of traffic, from 0 to 100, that the parent endpoint's trafficSplit should route to this deployed model. The provider keeps the sum of all deployed models' traffic_percentage on the same endpoint at 100. If not set, creating the DeployedModel leaves the endpoint's trafficSplit unchanged and the value is read back from it.`,
+			},
+			"deployed_model_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The server-assigned ID of the DeployedModel.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+// resourceVertexAIDeployedModelImport accepts the resource's own id,
+// {{endpoint}}/deployedModels/{{deployed_model_id}}, and splits it back into
+// endpoint and deployed_model_id so the first Read can find the DeployedModel
+// on its parent endpoint.
+func resourceVertexAIDeployedModelImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/deployedModels/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid DeployedModel import id %q, expected {{endpoint}}/deployedModels/{{deployed_model_id}}", d.Id())
+	}
+
+	if err := d.Set("endpoint", parts[0]); err != nil {
+		return nil, fmt.Errorf("Error setting endpoint: %s", err)
+	}
+	if err := d.Set("deployed_model_id", parts[1]); err != nil {
+		return nil, fmt.Errorf("Error setting deployed_model_id: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVertexAIDeployedModelCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for DeployedModel: %s", err)
+	}
+
+	deployedModel := make(map[string]interface{})
+	if v, ok := d.GetOk("model"); ok {
+		deployedModel["model"] = v
+	}
+	if v, ok := d.GetOk("display_name"); ok {
+		deployedModel["displayName"] = v
+	}
+	if v, ok := d.GetOk("service_account"); ok {
+		deployedModel["serviceAccount"] = v
+	}
+	if v, ok := d.GetOkExists("enable_access_logging"); ok {
+		deployedModel["enableAccessLogging"] = v
+	}
+	if v, ok := d.GetOkExists("enable_container_logging"); ok {
+		deployedModel["enableContainerLogging"] = v
+	}
+	if v, ok := d.GetOkExists("disable_container_logging"); ok {
+		deployedModel["disableContainerLogging"] = v
+	}
+	if v, err := expandVertexAIDeployedModelDedicatedResources(d.Get("dedicated_resources"), d, config); err != nil {
+		return err
+	} else if !isEmptyValue(reflect.ValueOf(v)) {
+		deployedModel["dedicatedResources"] = v
+	}
+	if v, err := expandVertexAIDeployedModelAutomaticResources(d.Get("automatic_resources"), d, config); err != nil {
+		return err
+	} else if !isEmptyValue(reflect.ValueOf(v)) {
+		deployedModel["automaticResources"] = v
+	}
+
+	obj := map[string]interface{}{
+		"deployedModel": deployedModel,
+	}
+
+	endpoint := d.Get("endpoint").(string)
+	url, err := replaceVars(d, config, "{{VertexAIBasePath}}{{endpoint}}:deployModel")
+	if err != nil {
+		return err
+	}
+
+	billingProject := project
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	log.Printf("[DEBUG] Deploying new DeployedModel: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", billingProject, url, userAgent, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error deploying DeployedModel: %s", err)
+	}
+
+	var opRes map[string]interface{}
+	err = vertexAIOperationWaitTimeWithResponse(
+		config, res, &opRes, project, "Deploying DeployedModel", userAgent,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		d.SetId("")
+		return fmt.Errorf("Error waiting to deploy DeployedModel: %s", err)
+	}
+
+	deployedModelId := ""
+	if dm, ok := opRes["deployedModel"].(map[string]interface{}); ok {
+		deployedModelId, _ = dm["id"].(string)
+	}
+	if deployedModelId == "" {
+		return fmt.Errorf("Error deploying DeployedModel: no deployedModel.id returned in operation response")
+	}
+	if err := d.Set("deployed_model_id", deployedModelId); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/deployedModels/%s", endpoint, deployedModelId))
+
+	// The endpoint's trafficSplit map is shared by every DeployedModel on it,
+	// so re-normalizing it has to happen under a mutex keyed on the endpoint.
+	// Without traffic_percentage the split is left to whatever else owns it,
+	// e.g. a google_vertex_ai_endpoint_traffic_split resource.
+	if v, ok := d.GetOkExists("traffic_percentage"); ok {
+		mutexKV.Lock(vertexAIEndpointTrafficSplitMutexKey(endpoint))
+		defer mutexKV.Unlock(vertexAIEndpointTrafficSplitMutexKey(endpoint))
+
+		if err := vertexAISetDeployedModelTrafficPercentage(d, config, userAgent, project, billingProject, endpoint, deployedModelId, v.(int)); err != nil {
+			return fmt.Errorf("Error setting traffic split for DeployedModel: %s", err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished deploying DeployedModel %q", d.Id())
+
+	return resourceVertexAIDeployedModelRead(d, meta)
+}
+
+func resourceVertexAIDeployedModelRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for DeployedModel: %s", err)
+	}
+
+	billingProject := project
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	endpoint := d.Get("endpoint").(string)
+	url, err := replaceVars(d, config, "{{VertexAIBasePath}}{{endpoint}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", billingProject, url, userAgent, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("VertexAIDeployedModel %q", d.Id()))
+	}
+
+	deployedModelId := d.Get("deployed_model_id").(string)
+	found := false
+	if deployedModels, ok := res["deployedModels"].([]interface{}); ok {
+		for _, raw := range deployedModels {
+			dm := raw.(map[string]interface{})
+			if dm["id"] == deployedModelId {
+				found = true
+				if err := d.Set("display_name", dm["displayName"]); err != nil {
+					return fmt.Errorf("Error reading DeployedModel: %s", err)
+				}
+				if err := d.Set("service_account", dm["serviceAccount"]); err != nil {
+					return fmt.Errorf("Error reading DeployedModel: %s", err)
+				}
+				break
+			}
+		}
+	}
+	if !found {
+		log.Printf("[WARN] DeployedModel %q not found in endpoint %q, removing from state", deployedModelId, endpoint)
+		d.SetId("")
+		return nil
+	}
+
+	trafficPercentage := 0
+	if trafficSplit, ok := res["trafficSplit"].(map[string]interface{}); ok {
+		if v, ok := trafficSplit[deployedModelId]; ok {
+			if f, ok := v.(float64); ok {
+				trafficPercentage = int(f)
+			}
+		}
+	}
+	if err := d.Set("traffic_percentage", trafficPercentage); err != nil {
+		return fmt.Errorf("Error reading DeployedModel: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading DeployedModel: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVertexAIDeployedModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for DeployedModel: %s", err)
+	}
+
+	billingProject := project
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	endpoint := d.Get("endpoint").(string)
+	deployedModelId := d.Get("deployed_model_id").(string)
+
+	if d.HasChange("traffic_percentage") {
+		mutexKV.Lock(vertexAIEndpointTrafficSplitMutexKey(endpoint))
+		defer mutexKV.Unlock(vertexAIEndpointTrafficSplitMutexKey(endpoint))
+
+		trafficPercentage := d.Get("traffic_percentage").(int)
+		if err := vertexAISetDeployedModelTrafficPercentage(d, config, userAgent, project, billingProject, endpoint, deployedModelId, trafficPercentage); err != nil {
+			return fmt.Errorf("Error updating traffic split for DeployedModel: %s", err)
+		}
+	}
+
+	return resourceVertexAIDeployedModelRead(d, meta)
+}
+
+func resourceVertexAIDeployedModelDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for DeployedModel: %s", err)
+	}
+
+	billingProject := project
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	endpoint := d.Get("endpoint").(string)
+	deployedModelId := d.Get("deployed_model_id").(string)
+
+	url, err := replaceVars(d, config, "{{VertexAIBasePath}}{{endpoint}}:undeployModel")
+	if err != nil {
+		return err
+	}
+
+	// Hold the endpoint's traffic split lock across the undeploy + renormalize
+	// so a concurrently-managed DeployedModel can't observe a split that no
+	// longer sums to 100.
+	mutexKV.Lock(vertexAIEndpointTrafficSplitMutexKey(endpoint))
+	defer mutexKV.Unlock(vertexAIEndpointTrafficSplitMutexKey(endpoint))
+
+	obj := map[string]interface{}{
+		"deployedModelId": deployedModelId,
+	}
+
+	log.Printf("[DEBUG] Undeploying DeployedModel %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "POST", billingProject, url, userAgent, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "DeployedModel")
+	}
+
+	err = vertexAIOperationWaitTime(
+		config, res, project, "Undeploying DeployedModel", userAgent,
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+
+	if err := vertexAIRemoveDeployedModelTrafficSplit(d, config, userAgent, project, billingProject, endpoint, deployedModelId); err != nil {
+		return fmt.Errorf("Error re-normalizing traffic split after undeploying DeployedModel: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished undeploying DeployedModel %q", d.Id())
+	return nil
+}
+
+// vertexAISetDeployedModelTrafficPercentage PATCHes the parent endpoint's
+// trafficSplit map so that deployedModelId is set to percentage, rescaling
+// the other entries proportionally so the map still sums to 100. Callers must
+// hold vertexAIEndpointTrafficSplitMutexKey(endpoint) for the duration.
+func vertexAISetDeployedModelTrafficPercentage(d *schema.ResourceData, config *Config, userAgent, project, billingProject, endpoint, deployedModelId string, percentage int) error {
+	readUrl, err := replaceVars(d, config, "{{VertexAIBasePath}}{{endpoint}}")
+	if err != nil {
+		return err
+	}
+	res, err := sendRequest(config, "GET", billingProject, readUrl, userAgent, nil)
+	if err != nil {
+		return err
+	}
+
+	trafficSplit := map[string]interface{}{}
+	if existing, ok := res["trafficSplit"].(map[string]interface{}); ok {
+		for k, v := range existing {
+			trafficSplit[k] = v
+		}
+	}
+	trafficSplit[deployedModelId] = percentage
+
+	normalized, err := vertexAINormalizeTrafficSplit(trafficSplit, deployedModelId)
+	if err != nil {
+		return err
+	}
+
+	patchUrl, err := replaceVars(d, config, "{{VertexAIBasePath}}{{endpoint}}")
+	if err != nil {
+		return err
+	}
+	patchUrl, err = addQueryParams(patchUrl, map[string]string{"updateMask": "trafficSplit"})
+	if err != nil {
+		return err
+	}
+
+	patchObj := map[string]interface{}{
+		"trafficSplit": normalized,
+	}
+	patchRes, err := sendRequestWithTimeout(config, "PATCH", billingProject, patchUrl, userAgent, patchObj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return vertexAIOperationWaitTime(config, patchRes, project, "Updating Endpoint traffic split", userAgent, d.Timeout(schema.TimeoutCreate))
+}
+
+// vertexAIRemoveDeployedModelTrafficSplit drops deployedModelId from the
+// endpoint's trafficSplit map entirely and rescales the remaining entries so
+// they still sum to 100. Callers must hold
+// vertexAIEndpointTrafficSplitMutexKey(endpoint) for the duration.
+func vertexAIRemoveDeployedModelTrafficSplit(d *schema.ResourceData, config *Config, userAgent, project, billingProject, endpoint, deployedModelId string) error {
+	readUrl, err := replaceVars(d, config, "{{VertexAIBasePath}}{{endpoint}}")
+	if err != nil {
+		return err
+	}
+	res, err := sendRequest(config, "GET", billingProject, readUrl, userAgent, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("VertexAIEndpoint %q", endpoint))
+	}
+
+	trafficSplit := map[string]interface{}{}
+	if existing, ok := res["trafficSplit"].(map[string]interface{}); ok {
+		for k, v := range existing {
+			trafficSplit[k] = v
+		}
+	}
+	if _, ok := trafficSplit[deployedModelId]; !ok {
+		// Already gone from the split, e.g. a previous delete attempt
+		// already re-normalized it. Nothing to do.
+		return nil
+	}
+	delete(trafficSplit, deployedModelId)
+
+	normalized := vertexAINormalizeRemainingTrafficSplit(trafficSplit)
+
+	patchUrl, err := replaceVars(d, config, "{{VertexAIBasePath}}{{endpoint}}")
+	if err != nil {
+		return err
+	}
+	patchUrl, err = addQueryParams(patchUrl, map[string]string{"updateMask": "trafficSplit"})
+	if err != nil {
+		return err
+	}
+
+	patchObj := map[string]interface{}{
+		"trafficSplit": normalized,
+	}
+	patchRes, err := sendRequestWithTimeout(config, "PATCH", billingProject, patchUrl, userAgent, patchObj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+
+	return vertexAIOperationWaitTime(config, patchRes, project, "Updating Endpoint traffic split", userAgent, d.Timeout(schema.TimeoutDelete))
+}
+
+// vertexAINormalizeTrafficSplit rescales every entry in split except pinned so
+// that the whole map sums to 100, preserving pinned's own value exactly. It
+// errors if pinned is set to less than 100 but there are no other entries to
+// absorb the remainder, since there would be no way to keep the map summing
+// to 100.
+func vertexAINormalizeTrafficSplit(split map[string]interface{}, pinned string) (map[string]interface{}, error) {
+	pinnedValue := 0
+	if v, ok := split[pinned]; ok {
+		pinnedValue = toInt(v)
+	}
+
+	others := map[string]interface{}{}
+	for k, v := range split {
+		if k == pinned {
+			continue
+		}
+		others[k] = v
+	}
+
+	remaining := 100 - pinnedValue
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	if len(others) == 0 && remaining > 0 {
+		return nil, fmt.Errorf("traffic_percentage is %d, but there are no other DeployedModels on this endpoint to receive the remaining %d; set traffic_percentage to 100 for a sole DeployedModel", pinnedValue, remaining)
+	}
+
+	normalized := vertexAIDistributeTrafficSplit(others, remaining)
+	normalized[pinned] = pinnedValue
+	return normalized, nil
+}
+
+// vertexAINormalizeRemainingTrafficSplit rescales every entry in split so the
+// whole map sums to 100. Used after a DeployedModel is removed from the split
+// entirely, so there is no pinned entry to preserve.
+func vertexAINormalizeRemainingTrafficSplit(split map[string]interface{}) map[string]interface{} {
+	return vertexAIDistributeTrafficSplit(split, 100)
+}
+
+// vertexAIDistributeTrafficSplit allocates total across weights in proportion
+// to their current values, guaranteeing the result sums to exactly total.
+// Integer division truncates each share, so any rounding remainder is handed
+// out one point at a time, largest share first (ties broken by key for a
+// deterministic result), until the whole amount is accounted for.
+func vertexAIDistributeTrafficSplit(weights map[string]interface{}, total int) map[string]interface{} {
+	normalized := map[string]interface{}{}
+	if len(weights) == 0 {
+		return normalized
+	}
+
+	weightTotal := 0
+	for _, v := range weights {
+		weightTotal += toInt(v)
+	}
+
+	keys := make([]string, 0, len(weights))
+	for k := range weights {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	if weightTotal == 0 || total <= 0 {
+		for i, k := range keys {
+			if total > 0 && i == 0 {
+				normalized[k] = total
+			} else {
+				normalized[k] = 0
+			}
+		}
+		return normalized
+	}
+
+	distributed := 0
+	for _, k := range keys {
+		share := toInt(weights[k]) * total / weightTotal
+		normalized[k] = share
+		distributed += share
+	}
+
+	// Hand out the truncated remainder so the map always sums to total,
+	// largest current share first for a deterministic, predictable result.
+	remainder := total - distributed
+	sort.SliceStable(keys, func(i, j int) bool {
+		si, sj := normalized[keys[i]].(int), normalized[keys[j]].(int)
+		if si != sj {
+			return si > sj
+		}
+		return keys[i] < keys[j]
+	})
+	for i := 0; i < remainder; i++ {
+		k := keys[i%len(keys)]
+		normalized[k] = normalized[k].(int) + 1
+	}
+
+	return normalized
+}
+
+func toInt(v interface{}) int {
+	switch t := v.(type) {
+	case int:
+		return t
+	case float64:
+		return int(t)
+	default:
+		return 0
+	}
+}
+
+func expandVertexAIDeployedModelDedicatedResources(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0].(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	if machineSpecs, ok := raw["machine_spec"].([]interface{}); ok && len(machineSpecs) > 0 && machineSpecs[0] != nil {
+		ms := machineSpecs[0].(map[string]interface{})
+		machineSpec := make(map[string]interface{})
+		if v, ok := ms["machine_type"]; ok && v != "" {
+			machineSpec["machineType"] = v
+		}
+		if v, ok := ms["accelerator_type"]; ok && v != "" {
+			machineSpec["acceleratorType"] = v
+		}
+		if v, ok := ms["accelerator_count"]; ok && v != 0 {
+			machineSpec["acceleratorCount"] = v
+		}
+		transformed["machineSpec"] = machineSpec
+	}
+
+	transformed["minReplicaCount"] = raw["min_replica_count"]
+	if v, ok := raw["max_replica_count"]; ok && v != 0 {
+		transformed["maxReplicaCount"] = v
+	}
+
+	if specs, ok := raw["autoscaling_metric_specs"].([]interface{}); ok && len(specs) > 0 {
+		var transformedSpecs []interface{}
+		for _, s := range specs {
+			spec := s.(map[string]interface{})
+			transformedSpecs = append(transformedSpecs, map[string]interface{}{
+				"metricName": spec["metric_name"],
+				"target":     spec["target"],
+			})
+		}
+		transformed["autoscalingMetricSpecs"] = transformedSpecs
+	}
+
+	return transformed, nil
+}
+
+func expandVertexAIDeployedModelAutomaticResources(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0].(map[string]interface{})
+	transformed := make(map[string]interface{})
+	if v, ok := raw["min_replica_count"]; ok && v != 0 {
+		transformed["minReplicaCount"] = v
+	}
+	if v, ok := raw["max_replica_count"]; ok && v != 0 {
+		transformed["maxReplicaCount"] = v
+	}
+	return transformed, nil
+}
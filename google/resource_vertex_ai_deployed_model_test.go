@@ -0,0 +1,199 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVertexAIDeployedModel_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckVertexAIDeployedModelDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVertexAIDeployedModel_basic(context),
+			},
+			{
+				ResourceName:      "google_vertex_ai_deployed_model.deployed_model",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVertexAIDeployedModel_basic(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_project" "project" {}
+
+resource "google_vertex_ai_endpoint" "endpoint" {
+  display_name        = "tf-test-endpoint%{random_suffix}"
+  metadata_schema_uri = ""
+  region              = "us-central1"
+}
+
+resource "google_vertex_ai_deployed_model" "deployed_model" {
+  endpoint     = google_vertex_ai_endpoint.endpoint.name
+  model        = "projects/${data.google_project.project.number}/locations/us-central1/models/tf-test-model%{random_suffix}"
+  display_name = "tf-test-deployed-model%{random_suffix}"
+
+  dedicated_resources {
+    machine_spec {
+      machine_type = "n1-standard-2"
+    }
+    min_replica_count = 1
+    max_replica_count = 1
+  }
+
+  traffic_percentage = 100
+}
+`, context)
+}
+
+func testAccCheckVertexAIDeployedModelDestroyProducer(t *testing.T) func(s *terraform.State) error {
+	return func(s *terraform.State) error {
+		for name, rs := range s.RootModule().Resources {
+			if rs.Type != "google_vertex_ai_deployed_model" {
+				continue
+			}
+			if strings.HasPrefix(name, "data.") {
+				continue
+			}
+
+			config := googleProviderConfig(t)
+
+			url, err := replaceVarsForTest(config, rs, "{{VertexAIBasePath}}{{endpoint}}")
+			if err != nil {
+				return err
+			}
+
+			billingProject := ""
+			if bp, ok := rs.Primary.Attributes["billing_project"]; ok {
+				billingProject = bp
+			}
+
+			res, err := sendRequest(config, "GET", billingProject, url, config.userAgent, nil)
+			if err != nil {
+				// The endpoint itself being gone also satisfies "destroyed".
+				continue
+			}
+
+			deployedModelId := rs.Primary.Attributes["deployed_model_id"]
+			if deployedModels, ok := res["deployedModels"].([]interface{}); ok {
+				for _, raw := range deployedModels {
+					dm := raw.(map[string]interface{})
+					if dm["id"] == deployedModelId {
+						return fmt.Errorf("VertexAIDeployedModel %s still exists on endpoint", deployedModelId)
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestVertexAINormalizeTrafficSplit(t *testing.T) {
+	cases := map[string]struct {
+		split  map[string]interface{}
+		pinned string
+	}{
+		"pinned only": {
+			split:  map[string]interface{}{"a": 100},
+			pinned: "a",
+		},
+		"pinned plus one other": {
+			split:  map[string]interface{}{"a": 40, "b": 60},
+			pinned: "a",
+		},
+		"three equal-weight others, non-divisible remainder": {
+			split:  map[string]interface{}{"a": 0, "b": 1, "c": 1, "d": 1},
+			pinned: "a",
+		},
+		"other entries all zero": {
+			split:  map[string]interface{}{"a": 10, "b": 0, "c": 0},
+			pinned: "a",
+		},
+		"pinned takes the whole split": {
+			split:  map[string]interface{}{"a": 100, "b": 50},
+			pinned: "a",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := vertexAINormalizeTrafficSplit(tc.split, tc.pinned)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			total := 0
+			for _, v := range got {
+				total += toInt(v)
+			}
+			if total != 100 {
+				t.Errorf("normalized traffic split sums to %d, want 100: %#v", total, got)
+			}
+
+			if got[tc.pinned] != toInt(tc.split[tc.pinned]) {
+				t.Errorf("pinned entry %q = %v, want unchanged value %v", tc.pinned, got[tc.pinned], tc.split[tc.pinned])
+			}
+
+			for k := range tc.split {
+				if _, ok := got[k]; !ok {
+					t.Errorf("normalized split is missing key %q present in input", k)
+				}
+			}
+		})
+	}
+}
+
+func TestVertexAINormalizeTrafficSplit_soleModelPartialPercentageErrors(t *testing.T) {
+	split := map[string]interface{}{"a": 60}
+
+	if _, err := vertexAINormalizeTrafficSplit(split, "a"); err == nil {
+		t.Fatal("expected an error when the sole DeployedModel requests less than 100% traffic, got nil")
+	}
+}
+
+func TestVertexAINormalizeRemainingTrafficSplit(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"empty":            {},
+		"single entry":     {"a": 1},
+		"three way tie":    {"a": 1, "b": 1, "c": 1},
+		"uneven weights":   {"a": 7, "b": 2, "c": 1},
+		"all zero weights": {"a": 0, "b": 0},
+	}
+
+	for name, split := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := vertexAINormalizeRemainingTrafficSplit(split)
+
+			if len(split) == 0 {
+				if len(got) != 0 {
+					t.Errorf("expected empty result for empty input, got %#v", got)
+				}
+				return
+			}
+
+			total := 0
+			for _, v := range got {
+				total += toInt(v)
+			}
+			if total != 100 {
+				t.Errorf("normalized traffic split sums to %d, want 100: %#v", total, got)
+			}
+		})
+	}
+}
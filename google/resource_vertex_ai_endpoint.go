@@ -1,12 +1,14 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,6 +19,10 @@ func resourceVertexAIEndpoint() *schema.Resource {
 		Update: resourceVertexAIEndpointUpdate,
 		Delete: resourceVertexAIEndpointDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVertexAIEndpointImport,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(6 * time.Minute),
 			Update: schema.DefaultTimeout(6 * time.Minute),
@@ -88,9 +94,88 @@ Has the form: projects/my-project/locations/my-region/keyRings/my-kr/cryptoKeys/
 				Computed: true,
 				ForceNew: true,
 			},
+			"network": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The full name of the Google Compute Engine network to which the Endpoint should be peered, in the format projects/{project_number}/global/networks/{network}. Private Service Access must already be configured on the network. Cannot be set together with private_service_connect_config.`,
+			},
+			"enable_private_service_connect": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Whether the Endpoint is served by a Private Service Connect endpoint, reflecting private_service_connect_config.`,
+			},
+			"private_service_connect_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: `Configuration for Private Service Connect. Cannot be set together with network.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_private_service_connect": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `If true, expose the Endpoint via Private Service Connect.`,
+						},
+						"project_allowlist": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `A list of Projects from which the forwarding rule will target the service attachment.`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 		UseJSONNumber: true,
+
+		CustomizeDiff: customdiff.All(
+			resourceVertexAIEndpointNetworkCustomizeDiff,
+		),
+	}
+}
+
+// resourceVertexAIEndpointImport accepts either the fully-qualified
+// projects/{project}/locations/{region}/endpoints/{id} resource name or the
+// short {project}/{region}/{id} form, populating project, region, and name in
+// state so the first Read can build the endpoint's canonical name.
+func resourceVertexAIEndpointImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/endpoints/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{region}}/endpoints/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
 	}
+	d.SetId(id)
+
+	// Read builds its request URL from {{name}}, which must hold the full
+	// resource path, not just the short segment parseImportId captured.
+	if err := d.Set("name", id); err != nil {
+		return nil, fmt.Errorf("Error setting name: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVertexAIEndpointNetworkCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	_, hasNetwork := diff.GetOk("network")
+	pscConfig := diff.Get("private_service_connect_config").([]interface{})
+	hasPscConfig := len(pscConfig) > 0 && pscConfig[0] != nil
+
+	if hasNetwork && hasPscConfig {
+		return fmt.Errorf("`network` and `private_service_connect_config` are mutually exclusive")
+	}
+
+	return nil
 }
 
 func resourceVertexAIEndpointCreate(d *schema.ResourceData, meta interface{}) error {
@@ -125,6 +210,18 @@ func resourceVertexAIEndpointCreate(d *schema.ResourceData, meta interface{}) er
 	} else if v, ok := d.GetOkExists("metadata_schema_uri"); !isEmptyValue(reflect.ValueOf(metadataSchemaUriProp)) && (ok || !reflect.DeepEqual(v, metadataSchemaUriProp)) {
 		obj["metadataSchemaUri"] = metadataSchemaUriProp
 	}
+	networkProp, err := expandVertexAIEndpointNetwork(d.Get("network"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("network"); !isEmptyValue(reflect.ValueOf(networkProp)) && (ok || !reflect.DeepEqual(v, networkProp)) {
+		obj["network"] = networkProp
+	}
+	privateServiceConnectConfigProp, err := expandVertexAIEndpointPrivateServiceConnectConfig(d.Get("private_service_connect_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("private_service_connect_config"); !isEmptyValue(reflect.ValueOf(privateServiceConnectConfigProp)) && (ok || !reflect.DeepEqual(v, privateServiceConnectConfigProp)) {
+		obj["privateServiceConnectConfig"] = privateServiceConnectConfigProp
+	}
 
 	url, err := replaceVars(d, config, "{{VertexAIBasePath}}projects/{{project}}/locations/{{region}}/endpoints")
 	if err != nil {
@@ -240,6 +337,15 @@ func resourceVertexAIEndpointRead(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("metadata_schema_uri", flattenVertexAIEndpointMetadataSchemaUri(res["metadataSchemaUri"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Endpoint: %s", err)
 	}
+	if err := d.Set("network", flattenVertexAIEndpointNetwork(res["network"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Endpoint: %s", err)
+	}
+	if err := d.Set("enable_private_service_connect", flattenVertexAIEndpointEnablePrivateServiceConnect(res["privateServiceConnectConfig"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Endpoint: %s", err)
+	}
+	if err := d.Set("private_service_connect_config", flattenVertexAIEndpointPrivateServiceConnectConfig(res["privateServiceConnectConfig"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Endpoint: %s", err)
+	}
 
 	return nil
 }
@@ -405,6 +511,44 @@ func flattenVertexAIEndpointMetadataSchemaUri(v interface{}, d *schema.ResourceD
 	return v
 }
 
+func flattenVertexAIEndpointNetwork(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	return v
+}
+
+func flattenVertexAIEndpointEnablePrivateServiceConnect(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	if v == nil {
+		return false
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return false
+	}
+	return original["enablePrivateServiceConnect"]
+}
+
+func flattenVertexAIEndpointPrivateServiceConnectConfig(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["enable_private_service_connect"] =
+		flattenVertexAIEndpointPrivateServiceConnectConfigEnablePrivateServiceConnect(original["enablePrivateServiceConnect"], d, config)
+	transformed["project_allowlist"] =
+		flattenVertexAIEndpointPrivateServiceConnectConfigProjectAllowlist(original["projectAllowlist"], d, config)
+	return []interface{}{transformed}
+}
+func flattenVertexAIEndpointPrivateServiceConnectConfigEnablePrivateServiceConnect(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	return v
+}
+
+func flattenVertexAIEndpointPrivateServiceConnectConfigProjectAllowlist(v interface{}, d *schema.ResourceData, config *Config) interface{} {
+	return v
+}
+
 func expandVertexAIEndpointDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
@@ -446,3 +590,41 @@ func expandVertexAIEndpointEncryptionSpecKmsKeyName(v interface{}, d TerraformRe
 func expandVertexAIEndpointMetadataSchemaUri(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
+
+func expandVertexAIEndpointNetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandVertexAIEndpointPrivateServiceConnectConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedEnablePrivateServiceConnect, err := expandVertexAIEndpointPrivateServiceConnectConfigEnablePrivateServiceConnect(original["enable_private_service_connect"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedEnablePrivateServiceConnect); val.IsValid() && !isEmptyValue(val) {
+		transformed["enablePrivateServiceConnect"] = transformedEnablePrivateServiceConnect
+	}
+
+	transformedProjectAllowlist, err := expandVertexAIEndpointPrivateServiceConnectConfigProjectAllowlist(original["project_allowlist"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProjectAllowlist); val.IsValid() && !isEmptyValue(val) {
+		transformed["projectAllowlist"] = transformedProjectAllowlist
+	}
+
+	return transformed, nil
+}
+
+func expandVertexAIEndpointPrivateServiceConnectConfigEnablePrivateServiceConnect(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandVertexAIEndpointPrivateServiceConnectConfigProjectAllowlist(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
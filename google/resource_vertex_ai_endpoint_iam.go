@@ -0,0 +1,17 @@
+package google
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceVertexAIEndpointIamPolicy() *schema.Resource {
+	return ResourceIamPolicy(IamVertexAIEndpointSchema, NewVertexAIEndpointIamUpdater, VertexAIEndpointIdParseFunc)
+}
+
+func resourceVertexAIEndpointIamBinding() *schema.Resource {
+	return ResourceIamBindingWithBatching(IamVertexAIEndpointSchema, NewVertexAIEndpointIamUpdater, VertexAIEndpointIdParseFunc, IamBatchingEnabled)
+}
+
+func resourceVertexAIEndpointIamMember() *schema.Resource {
+	return ResourceIamMemberWithBatching(IamVertexAIEndpointSchema, NewVertexAIEndpointIamUpdater, VertexAIEndpointIdParseFunc, IamBatchingEnabled)
+}
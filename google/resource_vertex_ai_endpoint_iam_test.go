@@ -0,0 +1,177 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVertexAIEndpointIamBindingGenerated(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+		"role":          "roles/aiplatform.admin",
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVertexAIEndpointIamBinding_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVertexAIEndpointIamBindingExists(t, "google_vertex_ai_endpoint_iam_binding.foo", context["role"].(string)),
+				),
+			},
+			{
+				ResourceName:      "google_vertex_ai_endpoint_iam_binding.foo",
+				ImportStateIdFunc: testAccVertexAIEndpointIamImportStateIdFunc(context["role"].(string)),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVertexAIEndpointIamMemberGenerated(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+		"role":          "roles/aiplatform.user",
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVertexAIEndpointIamMember_basic(context),
+			},
+		},
+	})
+}
+
+func TestAccVertexAIEndpointIamPolicyGenerated(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+		"role":          "roles/aiplatform.viewer",
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVertexAIEndpointIamPolicy_basic(context),
+			},
+			{
+				ResourceName:      "google_vertex_ai_endpoint_iam_policy.foo",
+				ImportStateIdFunc: testAccVertexAIEndpointIamImportStateIdFunc(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccVertexAIEndpointIamImportStateIdFunc builds an IAM import id from the
+// endpoint's resource name, followed by any extra parts (such as a role),
+// separated by spaces.
+func testAccVertexAIEndpointIamImportStateIdFunc(extra ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources["google_vertex_ai_endpoint.endpoint"]
+		if !ok {
+			return "", fmt.Errorf("resource not found: google_vertex_ai_endpoint.endpoint")
+		}
+		return strings.Join(append([]string{rs.Primary.Attributes["name"]}, extra...), " "), nil
+	}
+}
+
+func testAccCheckVertexAIEndpointIamBindingExists(t *testing.T, bindingResourceName, role string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[bindingResourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", bindingResourceName)
+		}
+
+		config := googleProviderConfig(t)
+		updater, err := NewVertexAIEndpointIamUpdater(resourceVertexAIEndpointIamBinding().Data(rs.Primary), config)
+		if err != nil {
+			return err
+		}
+
+		policy, err := updater.GetResourceIamPolicy(config.userAgent)
+		if err != nil {
+			return err
+		}
+
+		for _, binding := range policy.Bindings {
+			if binding.Role == role {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("no binding for role %q found in policy for %s", role, bindingResourceName)
+	}
+}
+
+func testAccVertexAIEndpointIamMember_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_vertex_ai_endpoint" "endpoint" {
+  display_name        = "tf-test-endpoint%{random_suffix}"
+  metadata_schema_uri = ""
+  region               = "us-central1"
+}
+
+resource "google_vertex_ai_endpoint_iam_member" "foo" {
+  endpoint = google_vertex_ai_endpoint.endpoint.name
+  role     = "%{role}"
+  member   = "user:admin@hashicorptest.com"
+}
+`, context)
+}
+
+func testAccVertexAIEndpointIamBinding_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_vertex_ai_endpoint" "endpoint" {
+  display_name        = "tf-test-endpoint%{random_suffix}"
+  metadata_schema_uri = ""
+  region               = "us-central1"
+}
+
+resource "google_vertex_ai_endpoint_iam_binding" "foo" {
+  endpoint = google_vertex_ai_endpoint.endpoint.name
+  role     = "%{role}"
+  members  = ["user:admin@hashicorptest.com"]
+}
+`, context)
+}
+
+func testAccVertexAIEndpointIamPolicy_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_vertex_ai_endpoint" "endpoint" {
+  display_name        = "tf-test-endpoint%{random_suffix}"
+  metadata_schema_uri = ""
+  region               = "us-central1"
+}
+
+data "google_iam_policy" "foo" {
+  binding {
+    role    = "%{role}"
+    members = ["user:admin@hashicorptest.com"]
+  }
+}
+
+resource "google_vertex_ai_endpoint_iam_policy" "foo" {
+  endpoint    = google_vertex_ai_endpoint.endpoint.name
+  policy_data = data.google_iam_policy.foo.policy_data
+}
+`, context)
+}
@@ -0,0 +1,118 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVertexAIEndpoint_privateServiceConnect(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckVertexAIEndpointDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVertexAIEndpoint_privateServiceConnect(context),
+			},
+			{
+				ResourceName:      "google_vertex_ai_endpoint.endpoint",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVertexAIEndpoint_privateServiceConnect(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_vertex_ai_endpoint" "endpoint" {
+  display_name        = "tf-test-endpoint%{random_suffix}"
+  metadata_schema_uri = ""
+  region               = "us-central1"
+
+  private_service_connect_config {
+    enable_private_service_connect = true
+  }
+}
+`, context)
+}
+
+// TestAccVertexAIEndpoint_networkPscConflict verifies the CustomizeDiff added
+// for network / private_service_connect_config rejects configs that set both.
+func TestAccVertexAIEndpoint_networkPscConflict(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckVertexAIEndpointDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVertexAIEndpoint_networkPscConflict(context),
+				ExpectError: regexp.MustCompile("mutually exclusive"),
+			},
+		},
+	})
+}
+
+func testAccVertexAIEndpoint_networkPscConflict(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_vertex_ai_endpoint" "endpoint" {
+  display_name        = "tf-test-endpoint%{random_suffix}"
+  metadata_schema_uri = ""
+  region               = "us-central1"
+  network              = "projects/123456789/global/networks/default"
+
+  private_service_connect_config {
+    enable_private_service_connect = true
+  }
+}
+`, context)
+}
+
+func testAccCheckVertexAIEndpointDestroyProducer(t *testing.T) func(s *terraform.State) error {
+	return func(s *terraform.State) error {
+		for name, rs := range s.RootModule().Resources {
+			if rs.Type != "google_vertex_ai_endpoint" {
+				continue
+			}
+			if strings.HasPrefix(name, "data.") {
+				continue
+			}
+
+			config := googleProviderConfig(t)
+
+			url, err := replaceVarsForTest(config, rs, "{{VertexAIBasePath}}{{name}}")
+			if err != nil {
+				return err
+			}
+
+			billingProject := ""
+			if bp, ok := rs.Primary.Attributes["billing_project"]; ok {
+				billingProject = bp
+			}
+
+			_, err = sendRequest(config, "GET", billingProject, url, config.userAgent, nil)
+			if err == nil {
+				return fmt.Errorf("VertexAIEndpoint still exists at %s", url)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,221 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceVertexAIEndpointTrafficSplit manages the whole trafficSplit map on
+// an endpoint in one resource, for configurations that would rather own the
+// split explicitly than let each google_vertex_ai_deployed_model manage its
+// own slice of it.
+func resourceVertexAIEndpointTrafficSplit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVertexAIEndpointTrafficSplitCreateUpdate,
+		Read:   resourceVertexAIEndpointTrafficSplitRead,
+		Update: resourceVertexAIEndpointTrafficSplitCreateUpdate,
+		Delete: resourceVertexAIEndpointTrafficSplitDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVertexAIEndpointTrafficSplitImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"endpoint": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of the Endpoint resource whose trafficSplit is managed, in the format projects/{project}/locations/{region}/endpoints/{endpoint}.`,
+			},
+			"traffic_split": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: `A map from a DeployedModel's id to the percentage of this Endpoint's traffic that should be forwarded to it. The values must sum to 100.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// resourceVertexAIEndpointTrafficSplitImport accepts the endpoint's resource
+// name, which is also this resource's id, and sets endpoint from it.
+func resourceVertexAIEndpointTrafficSplitImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("endpoint", d.Id()); err != nil {
+		return nil, fmt.Errorf("Error setting endpoint: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// vertexAIValidateTrafficSplitTotal returns an error unless the percentages in
+// trafficSplit sum to exactly 100, the invariant the API enforces on the
+// endpoint's trafficSplit field.
+func vertexAIValidateTrafficSplitTotal(trafficSplit map[string]interface{}) error {
+	total := 0
+	for _, v := range trafficSplit {
+		total += toInt(v)
+	}
+	if total != 100 {
+		return fmt.Errorf("traffic_split values must sum to 100, got %d", total)
+	}
+	return nil
+}
+
+func resourceVertexAIEndpointTrafficSplitCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for EndpointTrafficSplit: %s", err)
+	}
+
+	billingProject := project
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	endpoint := d.Get("endpoint").(string)
+
+	trafficSplit := map[string]interface{}{}
+	for k, v := range d.Get("traffic_split").(map[string]interface{}) {
+		trafficSplit[k] = v.(int)
+	}
+	if err := vertexAIValidateTrafficSplitTotal(trafficSplit); err != nil {
+		return fmt.Errorf("Error updating EndpointTrafficSplit: %s", err)
+	}
+
+	mutexKV.Lock(vertexAIEndpointTrafficSplitMutexKey(endpoint))
+	defer mutexKV.Unlock(vertexAIEndpointTrafficSplitMutexKey(endpoint))
+
+	url, err := replaceVars(d, config, "{{VertexAIBasePath}}{{endpoint}}")
+	if err != nil {
+		return err
+	}
+	url, err = addQueryParams(url, map[string]string{"updateMask": "trafficSplit"})
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"trafficSplit": trafficSplit,
+	}
+
+	log.Printf("[DEBUG] Updating Endpoint traffic split %#v", obj)
+	res, err := sendRequestWithTimeout(config, "PATCH", billingProject, url, userAgent, obj, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error updating EndpointTrafficSplit: %s", err)
+	}
+
+	err = vertexAIOperationWaitTime(
+		config, res, project, "Updating Endpoint traffic split", userAgent,
+		d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(endpoint)
+
+	return resourceVertexAIEndpointTrafficSplitRead(d, meta)
+}
+
+func resourceVertexAIEndpointTrafficSplitRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for EndpointTrafficSplit: %s", err)
+	}
+
+	billingProject := project
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	url, err := replaceVars(d, config, "{{VertexAIBasePath}}{{endpoint}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", billingProject, url, userAgent, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("VertexAIEndpointTrafficSplit %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading EndpointTrafficSplit: %s", err)
+	}
+	if err := d.Set("traffic_split", res["trafficSplit"]); err != nil {
+		return fmt.Errorf("Error reading EndpointTrafficSplit: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVertexAIEndpointTrafficSplitDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for EndpointTrafficSplit: %s", err)
+	}
+
+	billingProject := project
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	endpoint := d.Get("endpoint").(string)
+
+	mutexKV.Lock(vertexAIEndpointTrafficSplitMutexKey(endpoint))
+	defer mutexKV.Unlock(vertexAIEndpointTrafficSplitMutexKey(endpoint))
+
+	url, err := replaceVars(d, config, "{{VertexAIBasePath}}{{endpoint}}")
+	if err != nil {
+		return err
+	}
+	url, err = addQueryParams(url, map[string]string{"updateMask": "trafficSplit"})
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"trafficSplit": map[string]interface{}{},
+	}
+
+	log.Printf("[DEBUG] Clearing Endpoint traffic split %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "PATCH", billingProject, url, userAgent, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "EndpointTrafficSplit")
+	}
+
+	return vertexAIOperationWaitTime(
+		config, res, project, "Clearing Endpoint traffic split", userAgent,
+		d.Timeout(schema.TimeoutDelete))
+}
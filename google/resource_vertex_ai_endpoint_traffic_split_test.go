@@ -0,0 +1,101 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestVertexAIValidateTrafficSplitTotal(t *testing.T) {
+	cases := map[string]struct {
+		trafficSplit map[string]interface{}
+		wantErr      bool
+	}{
+		"sums to 100": {
+			trafficSplit: map[string]interface{}{"a": 40, "b": 60},
+			wantErr:      false,
+		},
+		"single entry at 100": {
+			trafficSplit: map[string]interface{}{"a": 100},
+			wantErr:      false,
+		},
+		"sums to less than 100": {
+			trafficSplit: map[string]interface{}{"a": 40, "b": 50},
+			wantErr:      true,
+		},
+		"sums to more than 100": {
+			trafficSplit: map[string]interface{}{"a": 60, "b": 60},
+			wantErr:      true,
+		},
+		"empty": {
+			trafficSplit: map[string]interface{}{},
+			wantErr:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := vertexAIValidateTrafficSplitTotal(tc.trafficSplit)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("vertexAIValidateTrafficSplitTotal(%#v) error = %v, wantErr %v", tc.trafficSplit, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccVertexAIEndpointTrafficSplit_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVertexAIEndpointTrafficSplit_basic(context),
+			},
+			{
+				ResourceName:      "google_vertex_ai_endpoint_traffic_split.traffic_split",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVertexAIEndpointTrafficSplit_basic(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_project" "project" {}
+
+resource "google_vertex_ai_endpoint" "endpoint" {
+  display_name        = "tf-test-endpoint%{random_suffix}"
+  metadata_schema_uri = ""
+  region              = "us-central1"
+}
+
+resource "google_vertex_ai_deployed_model" "deployed_model" {
+  endpoint     = google_vertex_ai_endpoint.endpoint.name
+  model        = "projects/${data.google_project.project.number}/locations/us-central1/models/tf-test-model%{random_suffix}"
+  display_name = "tf-test-deployed-model%{random_suffix}"
+
+  dedicated_resources {
+    machine_spec {
+      machine_type = "n1-standard-2"
+    }
+    min_replica_count = 1
+    max_replica_count = 1
+  }
+}
+
+resource "google_vertex_ai_endpoint_traffic_split" "traffic_split" {
+  endpoint = google_vertex_ai_endpoint.endpoint.name
+
+  traffic_split = {
+    (google_vertex_ai_deployed_model.deployed_model.deployed_model_id) = 100
+  }
+}
+`, context)
+}